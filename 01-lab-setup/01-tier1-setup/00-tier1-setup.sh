@@ -31,7 +31,6 @@ helm repo add nvidia https://helm.ngc.nvidia.com/nvidia --force-update
 helm repo add falcosecurity https://falcosecurity.github.io/charts --force-update
 helm repo add jetstack https://charts.jetstack.io --force-update
 helm repo add dandydev https://dandydeveloper.github.io/charts --force-update
-helm repo update
 echo -e "[INFO] ...done"
 
 # Base Stack Install
@@ -39,13 +38,18 @@ echo -e "[INFO] ...done"
 ## CNI Cilium installation
 echo -e "\n[INFO] Installing Cilium CNI..."
 
+### Resolving the API server port (IPv6-safe, 443 when the URL has no explicit port)
+K8S_SERVICE_PORT=$(kubectl config view --minify -o jsonpath='{.clusters[0].cluster.server}' \
+  | sed -nE 's|^[a-z]+://([^/]*).*$|\1|; s|^.*:([0-9]+)$|\1|p')
+K8S_SERVICE_PORT=${K8S_SERVICE_PORT:-443}
+
 ### Installing Cilium
 helm upgrade cilium cilium/cilium \
     --install \
     --namespace kube-system \
     -f ./resources/cilium/helm/cilium.yaml \
     --set k8sServiceHost=$(minikube ip) \
-    --set k8sServicePort=$(kubectl config view --minify -o jsonpath='{.clusters[0].cluster.server}' | sed -E 's|.*:(.*)|\1|') \
+    --set k8sServicePort=$K8S_SERVICE_PORT \
     --wait
 echo -e "[INFO] ...done."
 
@@ -131,8 +135,13 @@ sleep 5
 
 ### Wait until the Service has ready endpoints
 echo "[INFO] Waiting for trust-manager webhook endpoints..."
+ENDPOINTS_DEADLINE=$((SECONDS + 300))
 until kubectl -n cert-manager get endpoints trust-manager \
   -o jsonpath='{.subsets[*].addresses[*].ip}' | grep -qE '\S'; do
+  if [ "$SECONDS" -ge "$ENDPOINTS_DEADLINE" ]; then
+    echo "[ERROR] Timed out waiting for trust-manager webhook endpoints."
+    exit 1
+  fi
   echo "  - still waiting for endpoints..."
   sleep 2
 done
@@ -158,6 +167,8 @@ kubectl label namespace envoy-ai-gateway-system  trust-manager/inject-lab-ca-sec
 #### Inference Extension CRDs
 kubectl apply -f \
   https://github.com/kubernetes-sigs/gateway-api-inference-extension/releases/download/v1.2.1/manifests.yaml
+kubectl wait --for=condition=Established --timeout=60s -f \
+  https://github.com/kubernetes-sigs/gateway-api-inference-extension/releases/download/v1.2.1/manifests.yaml
 
 #### IA Gateway CRDs
 helm upgrade aieg-crd oci://docker.io/envoyproxy/ai-gateway-crds-helm \
@@ -166,6 +177,9 @@ helm upgrade aieg-crd oci://docker.io/envoyproxy/ai-gateway-crds-helm \
   --namespace envoy-ai-gateway-system \
   --wait
 
+helm get manifest aieg-crd --namespace envoy-ai-gateway-system \
+| kubectl wait --for=condition=Established --timeout=60s -f -
+
 #### Envoy IA Gaeway CRDs
 helm upgrade aieg oci://docker.io/envoyproxy/ai-gateway-helm \
   --install \
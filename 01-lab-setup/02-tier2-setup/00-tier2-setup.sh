@@ -32,7 +32,6 @@ helm repo add kyverno https://kyverno.github.io/kyverno/ --force-update
 helm repo add oauth2-proxy https://oauth2-proxy.github.io/manifests --force-update
 helm repo add cilium https://helm.cilium.io/ --force-update
 helm repo add vm https://victoriametrics.github.io/helm-charts --force-update
-helm repo update
 echo -e "[INFO] ...done."
 
 # Installing transversal stack
@@ -50,11 +49,13 @@ echo -e "[INFO] ...done."
 ### Keycloak Operator
 echo -e "\n[INFO] Installing Keycloak Operator..."
 kubectl create namespace keycloak --dry-run=client -o yaml | kubectl apply -f -
-kubectl label namespace keycloak service-type=keycloak
-kubectl label namespace keycloak trust-manager/inject-lab-ca-secret=enabled
+kubectl label namespace keycloak \
+    service-type=keycloak \
+    trust-manager/inject-lab-ca-secret=enabled
 
 kubectl -n keycloak apply -f https://raw.githubusercontent.com/keycloak/keycloak-k8s-resources/26.4.1/kubernetes/keycloaks.k8s.keycloak.org-v1.yml
 kubectl -n keycloak apply -f https://raw.githubusercontent.com/keycloak/keycloak-k8s-resources/26.4.1/kubernetes/keycloakrealmimports.k8s.keycloak.org-v1.yml
+kubectl wait crd/keycloaks.k8s.keycloak.org crd/keycloakrealmimports.k8s.keycloak.org --for=condition=Established --timeout=60s
 kubectl -n keycloak apply -f https://raw.githubusercontent.com/keycloak/keycloak-k8s-resources/26.4.1/kubernetes/kubernetes.yml
 
 kubectl -n keycloak wait deploy/keycloak-operator --for=condition=Available --timeout=300s
@@ -117,8 +118,9 @@ echo -e "[INFO] ...done."
 echo -e "\n[INFO] Installing Victoria Metrics K8S Stack..."
 kubectl create namespace victoriametrics --dry-run=client -o yaml | kubectl apply -f -
 
-kubectl label namespace victoriametrics service-type=lab
-kubectl label namespace victoriametrics trust-manager/inject-lab-ca-secret=enabled
+kubectl label namespace victoriametrics \
+    service-type=lab \
+    trust-manager/inject-lab-ca-secret=enabled
 
 kubectl -n victoriametrics apply -f ./resources/victoriametrics/configmaps
 kubectl -n victoriametrics apply -f ./resources/victoriametrics/secrets
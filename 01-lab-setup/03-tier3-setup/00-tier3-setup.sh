@@ -29,7 +29,6 @@ echo -e "\n[INFO] Adding Helm repositories..."
 helm repo add aphp-helix https://aphp.github.io/HELIX --force-update
 helm repo add llm-d-modelservice https://llm-d-incubation.github.io/llm-d-modelservice --force-update
 helm repo add open-webui https://helm.openwebui.com/ --force-update
-helm repo update
 echo -e "\n[INFO] ...done"
 
 # Installing applicative stack
@@ -37,8 +36,9 @@ echo -e "\n[INFO] ...done"
 ## llm-d stack
 echo -e "\n[INFO] Installing llm-d Stack..."
 kubectl create namespace llmd --dry-run=client -o yaml | kubectl apply -f -
-kubectl label namespace llmd trust-manager/inject-lab-ca-secret=enabled
-kubectl label namespace llmd service-type=llm
+kubectl label namespace llmd \
+    service-type=llm \
+    trust-manager/inject-lab-ca-secret=enabled
 
 ### llm-d
 helm upgrade llmd llm-d-modelservice/llm-d-modelservice \
@@ -66,8 +66,9 @@ echo -e "[INFO] ...done."
 ## Open WebUI
 echo -e "\n[INFO] Installing Open WebUI..."
 kubectl create namespace openwebui --dry-run=client -o yaml | kubectl apply -f -
-kubectl label namespace openwebui service-type=lab
-kubectl label namespace openwebui trust-manager/inject-lab-ca-secret=enabled
+kubectl label namespace openwebui \
+    service-type=lab \
+    trust-manager/inject-lab-ca-secret=enabled
 
 kubectl -n openwebui apply -f ./resources/openwebui/secrets
 
@@ -84,8 +85,9 @@ echo -e "[INFO] ...done."
 ## HELIX
 echo -e "\n[INFO] Installing HELIX..."
 kubectl create namespace helix --dry-run=client -o yaml | kubectl apply -f -
-kubectl label namespace helix trust-manager/inject-lab-ca-secret=enabled 
-kubectl label namespace helix service-type=lab
+kubectl label namespace helix \
+    service-type=lab \
+    trust-manager/inject-lab-ca-secret=enabled
 
 kubectl -n helix apply -R -f ./resources/helix/secrets
 
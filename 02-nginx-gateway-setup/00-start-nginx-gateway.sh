@@ -10,6 +10,16 @@
 echo -e "[INFO] Starting K8S Lab NGINX Gateway provisioning script v1.0"
 docker rm -f k8s-lab-nginx-gateway > /dev/null 2>&1
 
+echo -e "[INFO] Checking if host ports 80 and 443 are available..."
+for PORT in 80 443; do
+    if ss -Hltn "sport = :$PORT" | grep -q .; then
+        HOLDER=$(docker ps --filter "publish=$PORT" --format '{{.Names}}' | head -n1)
+        echo -e "[ERROR] ...host port $PORT is already in use${HOLDER:+ by container $HOLDER}! Please free it and launch the script again."
+        exit 1
+    fi
+done
+echo -e "[INFO] ...ports are available."
+
 echo -e "[INFO] Templating configuration files"
 export MINIKUBE_IP
 MINIKUBE_IP=$(minikube ip)
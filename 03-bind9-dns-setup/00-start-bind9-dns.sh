@@ -11,6 +11,14 @@
 echo -e "[INFO] Starting K8S Lab BIND9 DNS Server provisioning script v1.0"
 docker rm -f k8s-lab-bind9-dns > /dev/null 2>&1
 
+echo -e "[INFO] Checking if host port 30053 is available..."
+if ss -Hltun "sport = :30053" | grep -q .; then
+    HOLDER=$(docker ps --filter "publish=30053" --format '{{.Names}}' | head -n1)
+    echo -e "[ERROR] ...host port 30053 is already in use${HOLDER:+ by container $HOLDER}! Please free it and launch the script again."
+    exit 1
+fi
+echo -e "[INFO] ...port is available."
+
 docker run \
   --detach \
   --name k8s-lab-bind9-dns \